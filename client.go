@@ -2,79 +2,191 @@ package gowebflow
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/jancimertel/gowebflow/request"
 	"github.com/jancimertel/gowebflow/response"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"time"
 )
 
 const (
-	baseUrl    = "https://api.webflow.com"
-	apiVersion = "1.0.0"
-	pageSize   = 20
+	baseUrl           = "https://api.webflow.com"
+	apiVersion        = "1.0.0"
+	pageSize          = 20
+	defaultMaxRetries = 3
+	defaultRPS        = 1 // Webflow documents a 60 req/min limit
+	defaultBurst      = 60
 )
 
 // WebflowClient provides api calls as public methods
 type WebflowClient struct {
-	token    string
-	baseUrl  string
-	client   http.Client
-	pageSize uint
+	token       string
+	baseUrl     string
+	client      *http.Client
+	pageSize    uint
+	maxRetries  uint
+	backoff     func(attempt uint) time.Duration
+	rateLimiter *rateLimiter
 }
 
-// request makes a request to WebflowClient's API
-func (m *WebflowClient) request(requestData request.Envelope, responseData interface{}) error {
+// request makes a request to WebflowClient's API, retrying transient
+// failures (network errors, 429s, 5xx) up to m.maxRetries times. The
+// supplied context governs cancellation of the underlying HTTP calls and of
+// any sleeps between retry attempts.
+func (m *WebflowClient) request(ctx context.Context, requestData request.Envelope, responseData interface{}) error {
 	bytesData, err := json.Marshal(requestData.Body)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(string(requestData.Method), m.baseUrl+requestData.Path, bytes.NewReader(bytesData))
-	if err != nil {
-		return fmt.Errorf("could not create request: %s", err)
-	}
+	attempts := m.maxRetries + 1
+	var lastErr error
 
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", m.token))
-	req.Header.Add("Accept-Version", apiVersion)
+	for attempt := uint(0); attempt < attempts; attempt++ {
+		if err := m.rateLimiter.wait(ctx); err != nil {
+			return err
+		}
 
-	res, err := m.client.Do(req)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
+		req, err := http.NewRequestWithContext(ctx, string(requestData.Method), m.baseUrl+requestData.Path, bytes.NewReader(bytesData))
+		if err != nil {
+			return fmt.Errorf("could not create request: %s", err)
+		}
 
-	rawResponse, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return err
-	}
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", m.token))
+		req.Header.Add("Accept-Version", apiVersion)
+
+		res, err := m.client.Do(req)
+		if err != nil {
+			lastErr = err
+			if !m.canRetry(requestData, attempt, attempts) {
+				return err
+			}
+
+			if err = sleep(ctx, m.backoff(attempt)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		m.rateLimiter.updateFromHeaders(res.Header)
 
-	// in case of successful request - unmarshal to expected container
-	if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
-		if err = json.Unmarshal(rawResponse, responseData); err != nil {
+		rawResponse, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
 			return err
 		}
 
+		// in case of successful request - unmarshal to expected container
+		if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
+			if err = json.Unmarshal(rawResponse, responseData); err != nil {
+				return err
+			}
+
+			return nil
+		}
+
+		// in case of unsuccessful request - unmarshal to common error container
+		apiErr := &response.APIError{StatusCode: res.StatusCode}
+		if err = json.Unmarshal(rawResponse, apiErr); err != nil {
+			return err
+		}
+		lastErr = apiErr
+
+		if !isRetryableStatus(res.StatusCode) || !m.canRetry(requestData, attempt, attempts) {
+			return lastErr
+		}
+
+		delay := m.backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			delay = retryAfter
+		}
+		if err = sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+
+	return lastErr
+}
+
+// sleep waits for d, returning early with ctx.Err() if ctx is done first
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
 		return nil
 	}
+}
 
-	// in case of unsuccessful request - unmarshal to common error container
-	var errData response.Error
-	if err = json.Unmarshal(rawResponse, &errData); err != nil {
-		return err
+// canRetry decides whether a failed attempt may be retried: non-idempotent
+// methods are excluded unless the caller opted in via Envelope.AllowRetry
+func (m *WebflowClient) canRetry(requestData request.Envelope, attempt uint, attempts uint) bool {
+	if attempt+1 >= attempts {
+		return false
+	}
+
+	switch requestData.Method {
+	case request.MethodGet, request.MethodPut, request.MethodDelete:
+		return true
+	default:
+		return requestData.AllowRetry
+	}
+}
+
+// isRetryableStatus reports whether a response status code indicates a
+// transient failure worth retrying (rate limiting or a server error)
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// parseRetryAfter parses the Retry-After header, which Webflow sends as
+// either a number of seconds or an HTTP date
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
 	}
 
-	return fmt.Errorf("api returned an error (%d): %v", errData.Code, errData.Name)
+	if t, err := http.ParseTime(header); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+	}
+
+	return 0, false
+}
+
+// defaultBackoff returns a jittered exponential backoff: a base of 500ms, 1s,
+// 2s, ... (doubling per attempt) plus a random jitter of up to the base again
+func defaultBackoff(attempt uint) time.Duration {
+	base := (500 * time.Millisecond) << attempt
+	jitter := time.Duration(rand.Int63n(int64(base)))
+
+	return base + jitter
 }
 
 // GetSites returns list of sites associated with the curernt account
 // https://developers.webflow.com/#list-sites
 func (m *WebflowClient) GetSites() ([]response.Site, error) {
+	return m.GetSitesContext(context.Background())
+}
+
+// GetSitesContext is the context-aware variant of GetSites
+func (m *WebflowClient) GetSitesContext(ctx context.Context) ([]response.Site, error) {
 	var data []response.Site
-	err := m.request(request.Envelope{
+	err := m.request(ctx, request.Envelope{
 		Method: request.MethodGet,
 		Path:   "/sites",
 		Body:   nil,
@@ -86,8 +198,13 @@ func (m *WebflowClient) GetSites() ([]response.Site, error) {
 // GetCollections returns list of collections for specific site
 // https://developers.webflow.com/#collections
 func (m *WebflowClient) GetCollections(siteId string) ([]response.Collection, error) {
+	return m.GetCollectionsContext(context.Background(), siteId)
+}
+
+// GetCollectionsContext is the context-aware variant of GetCollections
+func (m *WebflowClient) GetCollectionsContext(ctx context.Context, siteId string) ([]response.Collection, error) {
 	var data []response.Collection
-	err := m.request(request.Envelope{
+	err := m.request(ctx, request.Envelope{
 		Method: request.MethodGet,
 		Path:   fmt.Sprintf("/sites/%s/collections", siteId),
 		Body:   nil,
@@ -99,8 +216,13 @@ func (m *WebflowClient) GetCollections(siteId string) ([]response.Collection, er
 // GetItems returns list of items from specified collection
 // https://developers.webflow.com/#get-all-items-for-a-collection
 func (m *WebflowClient) GetItems(collectionId string, limit uint, offset uint, itemsContainer interface{}) (hasNextPage bool, err error) {
+	return m.GetItemsContext(context.Background(), collectionId, limit, offset, itemsContainer)
+}
+
+// GetItemsContext is the context-aware variant of GetItems
+func (m *WebflowClient) GetItemsContext(ctx context.Context, collectionId string, limit uint, offset uint, itemsContainer interface{}) (hasNextPage bool, err error) {
 	var data response.GenericItems
-	err = m.request(request.Envelope{
+	err = m.request(ctx, request.Envelope{
 		Method: request.MethodGet,
 		Path:   fmt.Sprintf("/collections/%s/items?limit=%d&offset=%d", collectionId, limit, offset),
 		Body:   nil,
@@ -121,7 +243,12 @@ func (m *WebflowClient) GetItems(collectionId string, limit uint, offset uint, i
 // PaginateItems wraps GetItems method for easier paginating
 // first page starts with 0
 func (m *WebflowClient) PaginateItems(collectionId string, page uint, itemsContainer interface{}) (hasNextPage bool, err error) {
-	return m.GetItems(collectionId, m.pageSize, page*m.pageSize, itemsContainer)
+	return m.GetItemsContext(context.Background(), collectionId, m.pageSize, page*m.pageSize, itemsContainer)
+}
+
+// PaginateItemsContext is the context-aware variant of PaginateItems
+func (m *WebflowClient) PaginateItemsContext(ctx context.Context, collectionId string, page uint, itemsContainer interface{}) (hasNextPage bool, err error) {
+	return m.GetItemsContext(ctx, collectionId, m.pageSize, page*m.pageSize, itemsContainer)
 }
 
 type ClientOption func(client *WebflowClient)
@@ -132,6 +259,45 @@ func WithPageSize(size uint) ClientOption {
 	}
 }
 
+// WithMaxRetries sets the maximum number of retry attempts for transient
+// failures (network errors, 429s, 5xx). A value of 0 disables retries.
+func WithMaxRetries(n uint) ClientOption {
+	return func(client *WebflowClient) {
+		client.maxRetries = n
+	}
+}
+
+// WithBackoff overrides the delay strategy used between retry attempts. fn
+// receives the zero-based attempt number that just failed.
+func WithBackoff(fn func(attempt uint) time.Duration) ClientOption {
+	return func(client *WebflowClient) {
+		if fn != nil {
+			client.backoff = fn
+		}
+	}
+}
+
+// WithHTTPClient lets the caller supply their own *http.Client (e.g. for
+// custom transports, tracing, or tests) instead of the default one with a
+// hardcoded 10-second timeout.
+func WithHTTPClient(c *http.Client) ClientOption {
+	return func(client *WebflowClient) {
+		if c != nil {
+			client.client = c
+		}
+	}
+}
+
+// WithRateLimit overrides the client-side token-bucket rate limiter, letting
+// rps requests through per second on average with up to burst requests in a
+// single spike. The bucket is also kept in sync with Webflow's
+// X-RateLimit-Remaining response header as requests are made.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(client *WebflowClient) {
+		client.rateLimiter = newRateLimiter(rps, burst)
+	}
+}
+
 // NewClient returns new instance for the client structure
 func NewClient(secret string, options ...ClientOption) (*WebflowClient, error) {
 	if secret == "" {
@@ -140,10 +306,13 @@ func NewClient(secret string, options ...ClientOption) (*WebflowClient, error) {
 	client := &WebflowClient{
 		token:   secret,
 		baseUrl: baseUrl,
-		client: http.Client{
+		client: &http.Client{
 			Timeout: time.Second * 10,
 		},
-		pageSize: pageSize,
+		pageSize:    pageSize,
+		maxRetries:  defaultMaxRetries,
+		backoff:     defaultBackoff,
+		rateLimiter: newRateLimiter(defaultRPS, defaultBurst),
 	}
 
 	for _, option := range options {
@@ -0,0 +1,128 @@
+package gowebflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jancimertel/gowebflow/request"
+	"github.com/jancimertel/gowebflow/response"
+)
+
+// GetItem returns a single item from a collection
+// https://developers.webflow.com/#get-single-item
+func (m *WebflowClient) GetItem(collectionId string, itemId string, itemContainer interface{}) error {
+	return m.GetItemContext(context.Background(), collectionId, itemId, itemContainer)
+}
+
+// GetItemContext is the context-aware variant of GetItem
+func (m *WebflowClient) GetItemContext(ctx context.Context, collectionId string, itemId string, itemContainer interface{}) error {
+	var data json.RawMessage
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodGet,
+		Path:   fmt.Sprintf("/collections/%s/items/%s", collectionId, itemId),
+		Body:   nil,
+	}, &data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &itemContainer)
+}
+
+// CreateItem creates a new item in a collection
+// https://developers.webflow.com/#create-new-collection-item
+func (m *WebflowClient) CreateItem(collectionId string, fields request.ItemFields, itemContainer interface{}) error {
+	return m.CreateItemContext(context.Background(), collectionId, fields, itemContainer)
+}
+
+// CreateItemContext is the context-aware variant of CreateItem
+func (m *WebflowClient) CreateItemContext(ctx context.Context, collectionId string, fields request.ItemFields, itemContainer interface{}) error {
+	var data json.RawMessage
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodPost,
+		Path:   fmt.Sprintf("/collections/%s/items", collectionId),
+		Body:   request.CreateItemBody{Fields: fields},
+	}, &data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &itemContainer)
+}
+
+// UpdateItem replaces all fields of an existing item
+// https://developers.webflow.com/#update-collection-item
+func (m *WebflowClient) UpdateItem(collectionId string, itemId string, fields request.ItemFields, itemContainer interface{}) error {
+	return m.UpdateItemContext(context.Background(), collectionId, itemId, fields, itemContainer)
+}
+
+// UpdateItemContext is the context-aware variant of UpdateItem
+func (m *WebflowClient) UpdateItemContext(ctx context.Context, collectionId string, itemId string, fields request.ItemFields, itemContainer interface{}) error {
+	var data json.RawMessage
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodPut,
+		Path:   fmt.Sprintf("/collections/%s/items/%s", collectionId, itemId),
+		Body:   request.UpdateItemBody{Fields: fields},
+	}, &data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &itemContainer)
+}
+
+// PatchItem partially updates fields of an existing item
+// https://developers.webflow.com/#patch-collection-item
+func (m *WebflowClient) PatchItem(collectionId string, itemId string, fields request.ItemFields, itemContainer interface{}) error {
+	return m.PatchItemContext(context.Background(), collectionId, itemId, fields, itemContainer)
+}
+
+// PatchItemContext is the context-aware variant of PatchItem
+func (m *WebflowClient) PatchItemContext(ctx context.Context, collectionId string, itemId string, fields request.ItemFields, itemContainer interface{}) error {
+	var data json.RawMessage
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodPatch,
+		Path:   fmt.Sprintf("/collections/%s/items/%s", collectionId, itemId),
+		Body:   request.PatchItemBody{Fields: fields},
+	}, &data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &itemContainer)
+}
+
+// DeleteItem removes an item from a collection
+// https://developers.webflow.com/#remove-collection-item
+func (m *WebflowClient) DeleteItem(collectionId string, itemId string) error {
+	return m.DeleteItemContext(context.Background(), collectionId, itemId)
+}
+
+// DeleteItemContext is the context-aware variant of DeleteItem
+func (m *WebflowClient) DeleteItemContext(ctx context.Context, collectionId string, itemId string) error {
+	var data response.Deleted
+	return m.request(ctx, request.Envelope{
+		Method: request.MethodDelete,
+		Path:   fmt.Sprintf("/collections/%s/items/%s", collectionId, itemId),
+		Body:   nil,
+	}, &data)
+}
+
+// PublishItems pushes a set of collection items live
+// https://developers.webflow.com/#publish-item
+func (m *WebflowClient) PublishItems(collectionId string, itemIds []string) (response.PublishItemsResult, error) {
+	return m.PublishItemsContext(context.Background(), collectionId, itemIds)
+}
+
+// PublishItemsContext is the context-aware variant of PublishItems
+func (m *WebflowClient) PublishItemsContext(ctx context.Context, collectionId string, itemIds []string) (response.PublishItemsResult, error) {
+	var data response.PublishItemsResult
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodPut,
+		Path:   fmt.Sprintf("/collections/%s/items/publish", collectionId),
+		Body:   request.PublishItemsBody{ItemIds: itemIds},
+	}, &data)
+
+	return data, err
+}
@@ -0,0 +1,104 @@
+package gowebflow
+
+import "context"
+
+// fetchedPage is the result of prefetching one page of collection items
+type fetchedPage struct {
+	container interface{}
+	hasNext   bool
+	err       error
+}
+
+// CollectionIterator walks a collection's items page by page. It prefetches
+// the next page in a background goroutine while the caller processes the
+// current one, overlapping network I/O with user processing.
+type CollectionIterator struct {
+	client       *WebflowClient
+	ctx          context.Context
+	collectionId string
+	newContainer func() interface{}
+
+	nextPage uint
+	pending  chan fetchedPage
+	current  interface{}
+	done     bool
+	err      error
+}
+
+// IterateItems returns a CollectionIterator over a collection's items.
+// newContainer must return a fresh pointer to a slice that GetItems can
+// unmarshal a page of items into, e.g.:
+//
+//	it := client.IterateItems(ctx, collectionId, func() interface{} { return &[]MyItem{} })
+//	for it.Next() {
+//		page := it.Page().(*[]MyItem)
+//		...
+//	}
+//	if err := it.Err(); err != nil { ... }
+func (m *WebflowClient) IterateItems(ctx context.Context, collectionId string, newContainer func() interface{}) *CollectionIterator {
+	it := &CollectionIterator{
+		client:       m,
+		ctx:          ctx,
+		collectionId: collectionId,
+		newContainer: newContainer,
+	}
+	it.prefetch()
+
+	return it
+}
+
+// prefetch kicks off fetching the next page in a background goroutine
+func (it *CollectionIterator) prefetch() {
+	pending := make(chan fetchedPage, 1)
+	it.pending = pending
+
+	page := it.nextPage
+	it.nextPage++
+
+	go func() {
+		container := it.newContainer()
+		hasNext, err := it.client.PaginateItemsContext(it.ctx, it.collectionId, page, container)
+		pending <- fetchedPage{container: container, hasNext: hasNext, err: err}
+	}()
+}
+
+// Next blocks until the prefetched page is ready and advances the iterator
+// to it. It returns false once there are no more pages or an error occurred;
+// use Err to distinguish the two.
+func (it *CollectionIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	select {
+	case <-it.ctx.Done():
+		it.err = it.ctx.Err()
+		it.done = true
+		return false
+	case page := <-it.pending:
+		if page.err != nil {
+			it.err = page.err
+			it.done = true
+			return false
+		}
+
+		it.current = page.container
+		if page.hasNext {
+			it.prefetch()
+		} else {
+			it.done = true
+		}
+
+		return true
+	}
+}
+
+// Page returns the container populated by the most recent call to Next
+func (it *CollectionIterator) Page() interface{} {
+	return it.current
+}
+
+// Err returns the error, if any, that stopped iteration
+func (it *CollectionIterator) Err() error {
+	return it.err
+}
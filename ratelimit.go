@@ -0,0 +1,92 @@
+package gowebflow
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a simple token-bucket limiter used to stay under Webflow's
+// published rate limit and to react to the X-RateLimit-Remaining header
+// Webflow returns on every response. X-RateLimit-Limit is not read; the
+// bucket's rate and size come only from newRateLimiter's arguments.
+type rateLimiter struct {
+	mu       sync.Mutex
+	rps      float64
+	burst    float64
+	tokens   float64
+	lastFill time.Time
+}
+
+// newRateLimiter returns a limiter allowing rps requests per second on
+// average, with up to burst requests allowed in a single spike
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		rps:      rps,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastFill: time.Now(),
+	}
+}
+
+// wait blocks until a token is available, or ctx is done
+func (rl *rateLimiter) wait(ctx context.Context) error {
+	for {
+		rl.mu.Lock()
+		rl.refill()
+
+		if rl.tokens >= 1 {
+			rl.tokens--
+			rl.mu.Unlock()
+			return nil
+		}
+
+		delay := time.Duration(float64(time.Second) / rl.rps)
+		rl.mu.Unlock()
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// refill adds tokens earned since the last call, capped at the bucket's
+// burst size. Caller must hold rl.mu.
+func (rl *rateLimiter) refill() {
+	now := time.Now()
+	elapsed := now.Sub(rl.lastFill).Seconds()
+	rl.lastFill = now
+
+	rl.tokens += elapsed * rl.rps
+	if rl.tokens > rl.burst {
+		rl.tokens = rl.burst
+	}
+}
+
+// updateFromHeaders syncs the bucket down to Webflow's reported
+// X-RateLimit-Remaining so the client reacts immediately to server-side
+// usage instead of waiting for its own estimate to drift back in sync
+func (rl *rateLimiter) updateFromHeaders(header http.Header) {
+	remaining := header.Get("X-RateLimit-Remaining")
+	if remaining == "" {
+		return
+	}
+
+	tokens, err := strconv.ParseFloat(remaining, 64)
+	if err != nil {
+		return
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if tokens < rl.tokens {
+		rl.tokens = tokens
+	}
+}
@@ -0,0 +1,24 @@
+package request
+
+// Method represents the HTTP method used for a WebflowClient request
+type Method string
+
+const (
+	MethodGet    Method = "GET"
+	MethodPost   Method = "POST"
+	MethodPut    Method = "PUT"
+	MethodPatch  Method = "PATCH"
+	MethodDelete Method = "DELETE"
+)
+
+// Envelope wraps all data necessary to perform a request against the Webflow API
+type Envelope struct {
+	Method Method
+	Path   string
+	Body   interface{}
+
+	// AllowRetry opts a non-idempotent request (POST, PATCH) into the client's
+	// retry behaviour. GET, PUT and DELETE are retried by default since they
+	// are safe to repeat.
+	AllowRetry bool
+}
@@ -0,0 +1,26 @@
+package request
+
+// ItemFields holds the collection-specific field values for a CMS item.
+// Keys are each field's slug (e.g. "name", "slug", "_archived", "_draft")
+// plus any custom field defined on the collection.
+type ItemFields map[string]interface{}
+
+// CreateItemBody is the payload for WebflowClient.CreateItem
+type CreateItemBody struct {
+	Fields ItemFields `json:"fields"`
+}
+
+// UpdateItemBody is the payload for WebflowClient.UpdateItem (full replace)
+type UpdateItemBody struct {
+	Fields ItemFields `json:"fields"`
+}
+
+// PatchItemBody is the payload for WebflowClient.PatchItem (partial update)
+type PatchItemBody struct {
+	Fields ItemFields `json:"fields"`
+}
+
+// PublishItemsBody is the payload for WebflowClient.PublishItems
+type PublishItemsBody struct {
+	ItemIds []string `json:"itemIds"`
+}
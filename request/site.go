@@ -0,0 +1,6 @@
+package request
+
+// PublishSiteBody is the payload for WebflowClient.PublishSite
+type PublishSiteBody struct {
+	Domains []string `json:"domains"`
+}
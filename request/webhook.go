@@ -0,0 +1,8 @@
+package request
+
+// CreateWebhookBody is the payload for WebflowClient.CreateWebhook
+type CreateWebhookBody struct {
+	TriggerType string                 `json:"triggerType"`
+	URL         string                 `json:"url"`
+	Filter      map[string]interface{} `json:"filter,omitempty"`
+}
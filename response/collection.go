@@ -0,0 +1,12 @@
+package response
+
+import "time"
+
+// Collection represents a single CMS collection belonging to a site
+type Collection struct {
+	ID          string    `json:"_id"`
+	LastUpdated time.Time `json:"lastUpdated"`
+	CreatedOn   time.Time `json:"createdOn"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+}
@@ -0,0 +1,6 @@
+package response
+
+// Deleted is returned by delete endpoints that report an affected-row count
+type Deleted struct {
+	Deleted int `json:"deleted"`
+}
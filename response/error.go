@@ -0,0 +1,64 @@
+package response
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when the Webflow API responds with a non-2xx status.
+// It carries the structured error payload Webflow sends back alongside the
+// HTTP status code of the response.
+type APIError struct {
+	StatusCode int      `json:"-"`
+	Code       string   `json:"code"`
+	Name       string   `json:"name"`
+	Message    string   `json:"message"`
+	Path       string   `json:"path,omitempty"`
+	Err        []string `json:"err,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("webflow: %s (%d): %s", e.Name, e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("webflow: api error (%d): %s", e.StatusCode, e.Message)
+}
+
+// Unwrap satisfies errors.Unwrap; APIError has no underlying cause of its own
+func (e *APIError) Unwrap() error {
+	return nil
+}
+
+// IsRateLimited reports whether err is an APIError caused by hitting
+// Webflow's rate limit (HTTP 429)
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	return false
+}
+
+// IsNotFound reports whether err is an APIError for a missing resource (HTTP 404)
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
+
+// IsValidation reports whether err is an APIError caused by invalid input (HTTP 400)
+func IsValidation(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusBadRequest || strings.EqualFold(apiErr.Name, "ValidationError")
+	}
+
+	return false
+}
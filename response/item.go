@@ -0,0 +1,14 @@
+package response
+
+import "encoding/json"
+
+// GenericItems is the raw paginated response returned by the collection items
+// endpoint. Items is kept as raw JSON so callers can unmarshal it into their
+// own typed containers.
+type GenericItems struct {
+	Items  json.RawMessage `json:"items"`
+	Count  uint            `json:"count"`
+	Limit  uint            `json:"limit"`
+	Offset uint            `json:"offset"`
+	Total  uint            `json:"total"`
+}
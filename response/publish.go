@@ -0,0 +1,12 @@
+package response
+
+// PublishItemsResult is returned after publishing CMS items
+type PublishItemsResult struct {
+	PublishedItemIds []string `json:"publishedItemIds"`
+	Errors           []string `json:"errors"`
+}
+
+// PublishSiteResult is returned after queuing a site publish
+type PublishSiteResult struct {
+	Queued bool `json:"queued"`
+}
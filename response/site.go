@@ -0,0 +1,14 @@
+package response
+
+import "time"
+
+// Site represents a single Webflow site
+type Site struct {
+	ID            string    `json:"_id"`
+	Name          string    `json:"name"`
+	ShortName     string    `json:"shortName"`
+	Timezone      string    `json:"timezone"`
+	CreatedOn     time.Time `json:"createdOn"`
+	LastUpdated   time.Time `json:"lastUpdated"`
+	LastPublished time.Time `json:"lastPublished,omitempty"`
+}
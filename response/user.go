@@ -0,0 +1,12 @@
+package response
+
+import "time"
+
+// User represents a site member managed through the Webflow users/membership API
+type User struct {
+	ID        string    `json:"_id"`
+	Email     string    `json:"email"`
+	Status    string    `json:"status"`
+	CreatedOn time.Time `json:"createdOn"`
+	UpdatedOn time.Time `json:"updatedOn,omitempty"`
+}
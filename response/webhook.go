@@ -0,0 +1,12 @@
+package response
+
+import "time"
+
+// Webhook represents a registered site webhook
+type Webhook struct {
+	ID          string    `json:"_id"`
+	TriggerType string    `json:"triggerType"`
+	URL         string    `json:"url"`
+	SiteId      string    `json:"site"`
+	CreatedOn   time.Time `json:"createdOn"`
+}
@@ -0,0 +1,28 @@
+package gowebflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jancimertel/gowebflow/request"
+	"github.com/jancimertel/gowebflow/response"
+)
+
+// PublishSite queues a site for publishing to the given domains. An empty
+// domains slice publishes to all of the site's configured domains.
+// https://developers.webflow.com/#publish-site
+func (m *WebflowClient) PublishSite(siteId string, domains []string) (response.PublishSiteResult, error) {
+	return m.PublishSiteContext(context.Background(), siteId, domains)
+}
+
+// PublishSiteContext is the context-aware variant of PublishSite
+func (m *WebflowClient) PublishSiteContext(ctx context.Context, siteId string, domains []string) (response.PublishSiteResult, error) {
+	var data response.PublishSiteResult
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodPost,
+		Path:   fmt.Sprintf("/sites/%s/publish", siteId),
+		Body:   request.PublishSiteBody{Domains: domains},
+	}, &data)
+
+	return data, err
+}
@@ -0,0 +1,59 @@
+package gowebflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jancimertel/gowebflow/request"
+	"github.com/jancimertel/gowebflow/response"
+)
+
+// ListUsers returns all members (users) associated with a site
+// https://developers.webflow.com/#user-model
+func (m *WebflowClient) ListUsers(siteId string) ([]response.User, error) {
+	return m.ListUsersContext(context.Background(), siteId)
+}
+
+// ListUsersContext is the context-aware variant of ListUsers
+func (m *WebflowClient) ListUsersContext(ctx context.Context, siteId string) ([]response.User, error) {
+	var data []response.User
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodGet,
+		Path:   fmt.Sprintf("/sites/%s/users", siteId),
+		Body:   nil,
+	}, &data)
+
+	return data, err
+}
+
+// GetUser returns a single site member
+func (m *WebflowClient) GetUser(siteId string, userId string) (response.User, error) {
+	return m.GetUserContext(context.Background(), siteId, userId)
+}
+
+// GetUserContext is the context-aware variant of GetUser
+func (m *WebflowClient) GetUserContext(ctx context.Context, siteId string, userId string) (response.User, error) {
+	var data response.User
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodGet,
+		Path:   fmt.Sprintf("/sites/%s/users/%s", siteId, userId),
+		Body:   nil,
+	}, &data)
+
+	return data, err
+}
+
+// RemoveUser revokes a site member's access
+func (m *WebflowClient) RemoveUser(siteId string, userId string) error {
+	return m.RemoveUserContext(context.Background(), siteId, userId)
+}
+
+// RemoveUserContext is the context-aware variant of RemoveUser
+func (m *WebflowClient) RemoveUserContext(ctx context.Context, siteId string, userId string) error {
+	var data response.Deleted
+	return m.request(ctx, request.Envelope{
+		Method: request.MethodDelete,
+		Path:   fmt.Sprintf("/sites/%s/users/%s", siteId, userId),
+		Body:   nil,
+	}, &data)
+}
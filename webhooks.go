@@ -0,0 +1,61 @@
+package gowebflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jancimertel/gowebflow/request"
+	"github.com/jancimertel/gowebflow/response"
+)
+
+// ListWebhooks returns all webhooks registered for a site
+// https://developers.webflow.com/#list-all-webhooks
+func (m *WebflowClient) ListWebhooks(siteId string) ([]response.Webhook, error) {
+	return m.ListWebhooksContext(context.Background(), siteId)
+}
+
+// ListWebhooksContext is the context-aware variant of ListWebhooks
+func (m *WebflowClient) ListWebhooksContext(ctx context.Context, siteId string) ([]response.Webhook, error) {
+	var data []response.Webhook
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodGet,
+		Path:   fmt.Sprintf("/sites/%s/webhooks", siteId),
+		Body:   nil,
+	}, &data)
+
+	return data, err
+}
+
+// CreateWebhook registers a new webhook for a site
+// https://developers.webflow.com/#create-a-new-webhook
+func (m *WebflowClient) CreateWebhook(siteId string, body request.CreateWebhookBody) (response.Webhook, error) {
+	return m.CreateWebhookContext(context.Background(), siteId, body)
+}
+
+// CreateWebhookContext is the context-aware variant of CreateWebhook
+func (m *WebflowClient) CreateWebhookContext(ctx context.Context, siteId string, body request.CreateWebhookBody) (response.Webhook, error) {
+	var data response.Webhook
+	err := m.request(ctx, request.Envelope{
+		Method: request.MethodPost,
+		Path:   fmt.Sprintf("/sites/%s/webhooks", siteId),
+		Body:   body,
+	}, &data)
+
+	return data, err
+}
+
+// RemoveWebhook deletes a registered webhook
+// https://developers.webflow.com/#remove-a-webhook
+func (m *WebflowClient) RemoveWebhook(siteId string, webhookId string) error {
+	return m.RemoveWebhookContext(context.Background(), siteId, webhookId)
+}
+
+// RemoveWebhookContext is the context-aware variant of RemoveWebhook
+func (m *WebflowClient) RemoveWebhookContext(ctx context.Context, siteId string, webhookId string) error {
+	var data response.Deleted
+	return m.request(ctx, request.Envelope{
+		Method: request.MethodDelete,
+		Path:   fmt.Sprintf("/sites/%s/webhooks/%s", siteId, webhookId),
+		Body:   nil,
+	}, &data)
+}